@@ -5,15 +5,18 @@ import (
 	"bufio"
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
 
 	securejoin "github.com/cyphar/filepath-securejoin"
 	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/otiai10/copy"
 	"github.com/xeipuuv/gojsonschema"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -48,12 +51,13 @@ const (
 // extracts a tar file to a specified directory.
 type tarExtractor struct {
 	schemaLoader gojsonschema.JSONLoader
+	renameFn     func(oldpath, newpath string) error
 }
 
 // newTarExtractor returns an implementation of the promotion.StepRunner
 // interface that extracts a tar file.
 func newTarExtractor() promotion.StepRunner {
-	r := &tarExtractor{}
+	r := &tarExtractor{renameFn: os.Rename}
 	r.schemaLoader = getConfigSchemaLoader(r.Name())
 	return r
 }
@@ -399,20 +403,44 @@ func (t *tarExtractor) loadIgnoreRules(outPath, rules string) gitignore.Matcher
 	return gitignore.NewMatcher(ps)
 }
 
+// simpleAtomicMove moves src to dst, replacing dst if it already exists. When
+// src and dst reside on different filesystems, the rename is not possible and
+// it falls back to copying src to dst before removing src.
 func (t *tarExtractor) simpleAtomicMove(src, dst string) error {
-	if err := os.Rename(src, dst); err != nil {
-		if !os.IsExist(err) {
-			return fmt.Errorf("failed to move %s to %s: %w", src, dst, err)
-		}
-
+	err := t.renameFn(src, dst)
+	if err != nil && os.IsExist(err) {
 		// If the destination already exists, remove it and try again
-		if err := os.RemoveAll(dst); err != nil {
+		if err = os.RemoveAll(dst); err != nil {
 			return fmt.Errorf("failed to remove existing destination %s: %w", dst, err)
 		}
+		err = t.renameFn(src, dst)
+	}
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, syscall.EXDEV):
+		// The source and destination are on different filesystems (e.g. a
+		// tmpfs-backed volume), so a rename is not possible.
+		return t.crossDeviceMove(src, dst)
+	default:
+		return fmt.Errorf("failed to move %s to %s: %w", src, dst, err)
+	}
+}
 
-		if err := os.Rename(src, dst); err != nil {
-			return fmt.Errorf("failed to move %s to %s after removing existing: %w", src, dst, err)
-		}
+// crossDeviceMove copies src to dst, preserving file modes, and removes src
+// afterwards. It is used when src and dst cannot be renamed because they
+// reside on different filesystems.
+func (t *tarExtractor) crossDeviceMove(src, dst string) error {
+	if err := os.RemoveAll(dst); err != nil {
+		return fmt.Errorf("failed to remove existing destination %s: %w", dst, err)
+	}
+	// By default, copy.Copy preserves the permissions of the copied files and
+	// directories.
+	if err := copy.Copy(src, dst); err != nil {
+		return fmt.Errorf("failed to copy %s to %s: %w", src, dst, err)
+	}
+	if err := os.RemoveAll(src); err != nil {
+		return fmt.Errorf("failed to remove %s after copying to %s: %w", src, dst, err)
 	}
 	return nil
 }
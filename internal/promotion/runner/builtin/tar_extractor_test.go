@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"syscall"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -212,7 +213,7 @@ func Test_tarExtractor_run(t *testing.T) {
 		},
 	}
 
-	runner := &tarExtractor{}
+	runner := &tarExtractor{renameFn: os.Rename}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -823,6 +824,7 @@ func Test_tarExtractor_extractToDir(t *testing.T) {
 func Test_tarExtractor_simpleAtomicMove(t *testing.T) {
 	tests := []struct {
 		name       string
+		renameFn   func(oldpath, newpath string) error
 		setupFunc  func(*testing.T) (string, string)
 		assertions func(*testing.T, string, string, error)
 	}{
@@ -886,6 +888,51 @@ func Test_tarExtractor_simpleAtomicMove(t *testing.T) {
 				assert.True(t, os.IsNotExist(err))
 			},
 		},
+		{
+			name: "falls back to copy on cross-device error",
+			renameFn: func(string, string) error {
+				return &os.LinkError{Op: "rename", Err: syscall.EXDEV}
+			},
+			setupFunc: func(t *testing.T) (string, string) {
+				tmpDir := t.TempDir()
+				src := filepath.Join(tmpDir, "src")
+				dst := filepath.Join(tmpDir, "dst")
+
+				// Create source directory with nested content
+				require.NoError(t, os.MkdirAll(filepath.Join(src, "subdir"), 0o755))
+				require.NoError(t, os.WriteFile(filepath.Join(src, "file.txt"), []byte("content"), 0o600))
+				require.NoError(
+					t,
+					os.WriteFile(filepath.Join(src, "subdir", "script.sh"), []byte("#!/bin/sh"), 0o700),
+				)
+
+				// Create existing destination with different content
+				require.NoError(t, os.MkdirAll(dst, 0o755))
+				require.NoError(t, os.WriteFile(filepath.Join(dst, "old.txt"), []byte("old content"), 0o600))
+
+				return src, dst
+			},
+			assertions: func(t *testing.T, src, dst string, err error) {
+				assert.NoError(t, err)
+
+				// Source should no longer exist
+				_, err = os.Stat(src)
+				assert.True(t, os.IsNotExist(err))
+
+				// Destination should have the copied content and modes
+				content, err := os.ReadFile(filepath.Join(dst, "file.txt"))
+				assert.NoError(t, err)
+				assert.Equal(t, "content", string(content))
+
+				info, err := os.Stat(filepath.Join(dst, "subdir", "script.sh"))
+				require.NoError(t, err)
+				assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+
+				// Old file should not exist
+				_, err = os.Stat(filepath.Join(dst, "old.txt"))
+				assert.True(t, os.IsNotExist(err))
+			},
+		},
 		{
 			name: "fails when source doesn't exist",
 			setupFunc: func(t *testing.T) (string, string) {
@@ -908,7 +955,10 @@ func Test_tarExtractor_simpleAtomicMove(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			extractor := &tarExtractor{}
+			extractor := &tarExtractor{renameFn: os.Rename}
+			if tt.renameFn != nil {
+				extractor.renameFn = tt.renameFn
+			}
 			src, dst := tt.setupFunc(t)
 
 			err := extractor.simpleAtomicMove(src, dst)